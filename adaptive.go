@@ -0,0 +1,67 @@
+package gomsteams
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/vikramarsid/go-ms-teams/adaptivecard"
+)
+
+// AdaptiveCardContentType is the attachment content type Teams expects for
+// Adaptive Card payloads.
+const AdaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+
+// adaptiveCardAttachment wraps an Adaptive Card in the attachment envelope
+// Teams expects.
+type adaptiveCardAttachment struct {
+	ContentType string            `json:"contentType"`
+	Content     adaptivecard.Card `json:"content"`
+}
+
+// adaptiveCardMessage is the top level payload posted to the webhook when
+// sending an Adaptive Card.
+type adaptiveCardMessage struct {
+	Type        string                   `json:"type"`
+	Attachments []adaptiveCardAttachment `json:"attachments"`
+}
+
+// SendAdaptive - will post an Adaptive Card notification to a MS Teams
+// webhook URL. Microsoft is deprecating Office 365 connector MessageCards
+// in favor of Adaptive Cards; use this instead of Send for newer webhooks.
+func (c *Client) SendAdaptive(webhookURL string, card adaptivecard.Card) error {
+	return c.SendAdaptiveWithContext(context.Background(), webhookURL, card)
+}
+
+// SendAdaptiveWithContext - will post an Adaptive Card notification to a MS
+// Teams webhook URL, aborting the request if ctx is cancelled or its
+// deadline is exceeded.
+func (c *Client) SendAdaptiveWithContext(ctx context.Context, webhookURL string, card adaptivecard.Card) error {
+	// Validate input data
+	if valid, err := c.IsValidWebhookURL(webhookURL); !valid {
+		return err
+	}
+
+	payload := adaptiveCardMessage{
+		Type: "message",
+		Attachments: []adaptiveCardAttachment{
+			{
+				ContentType: AdaptiveCardContentType,
+				Content:     card,
+			},
+		},
+	}
+
+	// make new request
+	req, err := c.newRequest(ctx, http.MethodPost, webhookURL, payload)
+	if err != nil {
+		return fmt.Errorf("error in creating request, %s", err)
+	}
+
+	// do the request
+	if err := c.doRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
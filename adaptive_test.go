@@ -0,0 +1,51 @@
+package gomsteams
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vikramarsid/go-ms-teams/adaptivecard"
+)
+
+func TestClientSendAdaptiveEnvelopeShape(t *testing.T) {
+	card := *adaptivecard.NewCard(adaptivecard.Version14)
+	card.AddElement(adaptivecard.NewTextBlock("Hello World"))
+
+	var capturedBody []byte
+	client := NewTestClient(func(req *http.Request) (*http.Response, error) {
+		body, err := io.ReadAll(req.Body)
+		assert.Equal(t, nil, err)
+		capturedBody = body
+
+		return &http.Response{
+			StatusCode: 200,
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	opts := Options{Timeout: 60 * time.Second}
+	c := &Client{httpClient: client, options: &opts}
+
+	err := c.SendAdaptive("https://outlook.office.com/webhook/xxx", card)
+	assert.Equal(t, nil, err)
+
+	var envelope map[string]interface{}
+	assert.Equal(t, nil, json.Unmarshal(capturedBody, &envelope))
+
+	assert.Equal(t, "message", envelope["type"])
+
+	attachments, ok := envelope["attachments"].([]interface{})
+	assert.True(t, ok, "attachments should be a JSON array")
+	assert.Equal(t, 1, len(attachments))
+
+	attachment := attachments[0].(map[string]interface{})
+	assert.Equal(t, AdaptiveCardContentType, attachment["contentType"])
+
+	content, ok := attachment["content"].(map[string]interface{})
+	assert.True(t, ok, "content should be the marshaled Adaptive Card")
+	assert.Equal(t, "AdaptiveCard", content["type"])
+}
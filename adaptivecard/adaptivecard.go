@@ -0,0 +1,239 @@
+// Package adaptivecard provides types and builders for constructing
+// Adaptive Cards, the message format Microsoft Teams is migrating to in
+// place of the legacy Office 365 connector MessageCard.
+//
+// See https://adaptivecards.io/explorer/ for the full schema reference.
+package adaptivecard
+
+// Schema versions supported by the builders in this package.
+const (
+	Version14 = "1.4"
+	Version15 = "1.5"
+)
+
+// schemaURL is the $schema value Teams expects on every Adaptive Card.
+const schemaURL = "http://adaptivecards.io/schemas/adaptive-card.json"
+
+// Element is any object that may appear in a Card's Body or a Container's
+// Items (TextBlock, Container, FactSet, ColumnSet, Image, ActionSet, ...).
+type Element interface{}
+
+// Action is any object that may appear in a Card's Actions (ActionOpenURL,
+// ActionSubmit, ...).
+type Action interface{}
+
+// Card - top level Adaptive Card payload.
+type Card struct {
+	Type    string    `json:"type"`
+	Schema  string    `json:"$schema"`
+	Version string    `json:"version"`
+	Body    []Element `json:"body,omitempty"`
+	Actions []Action  `json:"actions,omitempty"`
+	MSTeams *MSTeams  `json:"msteams,omitempty"`
+}
+
+// NewCard - create a new Adaptive Card using the given schema version
+// (e.g. Version14, Version15). Defaults to Version14 if version is empty.
+func NewCard(version string) *Card {
+	if version == "" {
+		version = Version14
+	}
+
+	return &Card{
+		Type:    "AdaptiveCard",
+		Schema:  schemaURL,
+		Version: version,
+	}
+}
+
+// AddElement - adds a new element to the card body
+func (card *Card) AddElement(element Element) {
+	card.Body = append(card.Body, element)
+}
+
+// AddAction - adds a new action to the card
+func (card *Card) AddAction(action Action) {
+	card.Actions = append(card.Actions, action)
+}
+
+// AddMention - adds an @mention for the given user to the card, registering
+// it in the Teams-specific msteams.entities block. The returned Mention
+// text (e.g. "<at>Jane Doe</at>") must also be included in a TextBlock for
+// the mention to render.
+func (card *Card) AddMention(userID, userName string) string {
+	if card.MSTeams == nil {
+		card.MSTeams = &MSTeams{}
+	}
+
+	mentionText := "<at>" + userName + "</at>"
+	card.MSTeams.Entities = append(card.MSTeams.Entities, Entity{
+		Type: "mention",
+		Text: mentionText,
+		Mentioned: EntityMentioned{
+			ID:   userID,
+			Name: userName,
+		},
+	})
+
+	return mentionText
+}
+
+// MSTeams - msteams.entities block used for mentions
+type MSTeams struct {
+	Width    string   `json:"width,omitempty"`
+	Entities []Entity `json:"entities,omitempty"`
+}
+
+// Entity - a single msteams.entities mention
+type Entity struct {
+	Type      string          `json:"type"`
+	Text      string          `json:"text"`
+	Mentioned EntityMentioned `json:"mentioned"`
+}
+
+// EntityMentioned - the user referenced by an Entity
+type EntityMentioned struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// TextBlock - displays text, possibly wrapped
+type TextBlock struct {
+	Type   string `json:"type"`
+	Text   string `json:"text"`
+	Wrap   bool   `json:"wrap,omitempty"`
+	Weight string `json:"weight,omitempty"`
+	Size   string `json:"size,omitempty"`
+	Color  string `json:"color,omitempty"`
+}
+
+// NewTextBlock - create a new TextBlock element
+func NewTextBlock(text string) *TextBlock {
+	return &TextBlock{Type: "TextBlock", Text: text}
+}
+
+// Container - groups elements together
+type Container struct {
+	Type  string    `json:"type"`
+	Items []Element `json:"items,omitempty"`
+}
+
+// NewContainer - create a new Container element
+func NewContainer() *Container {
+	return &Container{Type: "Container"}
+}
+
+// AddItem - adds a new element to the container
+func (container *Container) AddItem(item Element) {
+	container.Items = append(container.Items, item)
+}
+
+// Fact - a single name/value pair in a FactSet
+type Fact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// FactSet - displays a series of facts (name/value pairs) in a tabular form
+type FactSet struct {
+	Type  string `json:"type"`
+	Facts []Fact `json:"facts,omitempty"`
+}
+
+// NewFactSet - create a new FactSet element
+func NewFactSet() *FactSet {
+	return &FactSet{Type: "FactSet"}
+}
+
+// AddFact - adds a new fact to the fact set
+func (factSet *FactSet) AddFact(title, value string) {
+	factSet.Facts = append(factSet.Facts, Fact{Title: title, Value: value})
+}
+
+// Column - a single column in a ColumnSet
+type Column struct {
+	Type  string    `json:"type"`
+	Width string    `json:"width,omitempty"`
+	Items []Element `json:"items,omitempty"`
+}
+
+// NewColumn - create a new Column with the given width ("auto", "stretch",
+// or a pixel/weighted value)
+func NewColumn(width string) *Column {
+	return &Column{Type: "Column", Width: width}
+}
+
+// AddItem - adds a new element to the column
+func (column *Column) AddItem(item Element) {
+	column.Items = append(column.Items, item)
+}
+
+// ColumnSet - displays a set of columns side by side
+type ColumnSet struct {
+	Type    string    `json:"type"`
+	Columns []*Column `json:"columns,omitempty"`
+}
+
+// NewColumnSet - create a new ColumnSet element
+func NewColumnSet() *ColumnSet {
+	return &ColumnSet{Type: "ColumnSet"}
+}
+
+// AddColumn - adds a new column to the column set
+func (columnSet *ColumnSet) AddColumn(column *Column) {
+	columnSet.Columns = append(columnSet.Columns, column)
+}
+
+// Image - displays an image
+type Image struct {
+	Type    string `json:"type"`
+	URL     string `json:"url"`
+	AltText string `json:"altText,omitempty"`
+	Size    string `json:"size,omitempty"`
+}
+
+// NewImage - create a new Image element
+func NewImage(url string) *Image {
+	return &Image{Type: "Image", URL: url}
+}
+
+// ActionSet - displays a set of actions inline within the card body
+type ActionSet struct {
+	Type    string   `json:"type"`
+	Actions []Action `json:"actions,omitempty"`
+}
+
+// NewActionSet - create a new ActionSet element
+func NewActionSet() *ActionSet {
+	return &ActionSet{Type: "ActionSet"}
+}
+
+// AddAction - adds a new action to the action set
+func (actionSet *ActionSet) AddAction(action Action) {
+	actionSet.Actions = append(actionSet.Actions, action)
+}
+
+// ActionOpenURL - action that opens a URL in the default browser
+type ActionOpenURL struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// NewActionOpenURL - create a new Action.OpenUrl action
+func NewActionOpenURL(title, url string) *ActionOpenURL {
+	return &ActionOpenURL{Type: "Action.OpenUrl", Title: title, URL: url}
+}
+
+// ActionSubmit - action that gathers input fields, merges with optional
+// data, and sends the data to the bot/webhook
+type ActionSubmit struct {
+	Type  string      `json:"type"`
+	Title string      `json:"title"`
+	Data  interface{} `json:"data,omitempty"`
+}
+
+// NewActionSubmit - create a new Action.Submit action
+func NewActionSubmit(title string, data interface{}) *ActionSubmit {
+	return &ActionSubmit{Type: "Action.Submit", Title: title, Data: data}
+}
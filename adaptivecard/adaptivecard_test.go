@@ -0,0 +1,104 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCardDefaultsToVersion14(t *testing.T) {
+	card := NewCard("")
+	assert.Equal(t, "AdaptiveCard", card.Type)
+	assert.Equal(t, schemaURL, card.Schema)
+	assert.Equal(t, Version14, card.Version)
+}
+
+func TestNewCardUsesGivenVersion(t *testing.T) {
+	card := NewCard(Version15)
+	assert.Equal(t, Version15, card.Version)
+}
+
+func TestCardMarshalsExpectedShape(t *testing.T) {
+	card := NewCard(Version14)
+	card.AddElement(NewTextBlock("hello"))
+	card.AddAction(NewActionOpenURL("Open", "https://example.com"))
+
+	b, err := json.Marshal(card)
+	assert.Equal(t, nil, err)
+
+	var got map[string]interface{}
+	assert.Equal(t, nil, json.Unmarshal(b, &got))
+
+	assert.Equal(t, "AdaptiveCard", got["type"])
+	assert.Equal(t, schemaURL, got["$schema"])
+	assert.Equal(t, "1.4", got["version"])
+
+	body, ok := got["body"].([]interface{})
+	assert.True(t, ok, "body should be a JSON array")
+	assert.Equal(t, 1, len(body))
+	textBlock := body[0].(map[string]interface{})
+	assert.Equal(t, "TextBlock", textBlock["type"])
+	assert.Equal(t, "hello", textBlock["text"])
+
+	actions, ok := got["actions"].([]interface{})
+	assert.True(t, ok, "actions should be a JSON array")
+	assert.Equal(t, 1, len(actions))
+	action := actions[0].(map[string]interface{})
+	assert.Equal(t, "Action.OpenUrl", action["type"])
+	assert.Equal(t, "https://example.com", action["url"])
+
+	_, hasMSTeams := got["msteams"]
+	assert.True(t, !hasMSTeams, "msteams block should be omitted when there are no mentions")
+}
+
+func TestAddMentionRegistersEntityAndReturnsPlaceholderText(t *testing.T) {
+	card := NewCard(Version14)
+	mentionText := card.AddMention("29:user-id", "Jane Doe")
+
+	assert.Equal(t, "<at>Jane Doe</at>", mentionText)
+
+	b, err := json.Marshal(card)
+	assert.Equal(t, nil, err)
+
+	var got map[string]interface{}
+	assert.Equal(t, nil, json.Unmarshal(b, &got))
+
+	msteams, ok := got["msteams"].(map[string]interface{})
+	assert.True(t, ok, "msteams block should be present once a mention is added")
+
+	entities, ok := msteams["entities"].([]interface{})
+	assert.True(t, ok, "entities should be a JSON array")
+	assert.Equal(t, 1, len(entities))
+
+	entity := entities[0].(map[string]interface{})
+	assert.Equal(t, "mention", entity["type"])
+	assert.Equal(t, "<at>Jane Doe</at>", entity["text"])
+
+	mentioned := entity["mentioned"].(map[string]interface{})
+	assert.Equal(t, "29:user-id", mentioned["id"])
+	assert.Equal(t, "Jane Doe", mentioned["name"])
+}
+
+func TestFactSetAndColumnSetBuilders(t *testing.T) {
+	factSet := NewFactSet()
+	factSet.AddFact("Status", "Green")
+
+	b, err := json.Marshal(factSet)
+	assert.Equal(t, nil, err)
+
+	var got map[string]interface{}
+	assert.Equal(t, nil, json.Unmarshal(b, &got))
+	assert.Equal(t, "FactSet", got["type"])
+
+	facts := got["facts"].([]interface{})
+	assert.Equal(t, 1, len(facts))
+	fact := facts[0].(map[string]interface{})
+	assert.Equal(t, "Status", fact["title"])
+	assert.Equal(t, "Green", fact["value"])
+
+	columnSet := NewColumnSet()
+	columnSet.AddColumn(NewColumn("auto"))
+	assert.Equal(t, 1, len(columnSet.Columns))
+	assert.Equal(t, "auto", columnSet.Columns[0].Width)
+}
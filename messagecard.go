@@ -0,0 +1,96 @@
+package gomsteams
+
+import "fmt"
+
+// MessageCard - MS Teams message card definition
+// https://docs.microsoft.com/en-us/outlook/actionable-messages/message-card-reference
+type MessageCard struct {
+	Type            string                       `json:"@type"`
+	Context         string                       `json:"@context"`
+	ThemeColor      string                       `json:"themeColor,omitempty"`
+	Summary         string                       `json:"summary,omitempty"`
+	Title           string                       `json:"title,omitempty"`
+	Text            string                       `json:"text,omitempty"`
+	Sections        []*MessageCardSection        `json:"sections,omitempty"`
+	PotentialAction []MessageCardPotentialAction `json:"potentialAction,omitempty"`
+
+	// ValidateFunc, when set, is called by Validate instead of the default
+	// "summary or text required" check. This lets callers with newer
+	// MessageCard schemas (Adaptive Cards, custom required fields, size
+	// limits) plug in their own validation rules without forking the client.
+	ValidateFunc func() error `json:"-"`
+}
+
+// MessageCardSection - MS Teams message card section definition
+type MessageCardSection struct {
+	Title            string                    `json:"title,omitempty"`
+	Text             string                    `json:"text,omitempty"`
+	ActivityTitle    string                    `json:"activityTitle,omitempty"`
+	ActivitySubtitle string                    `json:"activitySubtitle,omitempty"`
+	ActivityImage    string                    `json:"activityImage,omitempty"`
+	Facts            []MessageCardSectionFact  `json:"facts,omitempty"`
+	Images           []MessageCardSectionImage `json:"images,omitempty"`
+	Markdown         bool                      `json:"markdown,omitempty"`
+}
+
+// MessageCardSectionFact - MS Teams message card section fact definition
+type MessageCardSectionFact struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// MessageCardSectionImage - MS Teams message card section image definition
+type MessageCardSectionImage struct {
+	Image string `json:"image"`
+	Title string `json:"title,omitempty"`
+}
+
+// MessageCardPotentialAction - MS Teams message card potential action definition
+type MessageCardPotentialAction struct {
+	Type    string                             `json:"@type"`
+	Name    string                             `json:"name"`
+	Targets []MessageCardPotentialActionTarget `json:"targets,omitempty"`
+}
+
+// MessageCardPotentialActionTarget - MS Teams message card potential action target definition
+type MessageCardPotentialActionTarget struct {
+	OS  string `json:"os"`
+	URI string `json:"uri"`
+}
+
+// NewMessageCard - create a new MS Teams message card
+func NewMessageCard() MessageCard {
+	return MessageCard{
+		Type:    "MessageCard",
+		Context: "http://schema.org/extensions",
+	}
+}
+
+// AddSection - adds a new section to the message card
+func (card *MessageCard) AddSection(section *MessageCardSection) {
+	card.Sections = append(card.Sections, section)
+}
+
+// AddFact - adds a new fact to the message card section
+func (section *MessageCardSection) AddFact(fact MessageCardSectionFact) {
+	section.Facts = append(section.Facts, fact)
+}
+
+// Validate performs validation/checks for known issues with MessageCard
+// values. If ValidateFunc is set it is called instead, allowing callers to
+// supply their own validation rules; otherwise the default "summary or
+// text required" check is used.
+func (card MessageCard) Validate() error {
+	if card.ValidateFunc != nil {
+		return card.ValidateFunc()
+	}
+
+	if (card.Text == "") && (card.Summary == "") {
+		// This scenario results in:
+		// 400 Bad Request
+		// Summary or Text is required.
+		return fmt.Errorf("invalid message card: summary or text field is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package gomsteams
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageCardValidateDefaultChecksSummaryOrText(t *testing.T) {
+	empty := NewMessageCard()
+	assert.True(t, empty.Validate() != nil, "expected an error when neither summary nor text is set")
+
+	withText := NewMessageCard()
+	withText.Text = "hello"
+	assert.Equal(t, nil, withText.Validate())
+
+	withSummary := NewMessageCard()
+	withSummary.Summary = "hello"
+	assert.Equal(t, nil, withSummary.Validate())
+}
+
+func TestMessageCardValidateUsesValidateFuncWhenSet(t *testing.T) {
+	called := false
+	wantErr := errors.New("custom validation failed")
+
+	card := NewMessageCard()
+	card.ValidateFunc = func() error {
+		called = true
+		return wantErr
+	}
+
+	err := card.Validate()
+	assert.True(t, called, "expected ValidateFunc to be invoked")
+	assert.Equal(t, wantErr, err)
+}
+
+func TestMessageCardValidateFuncOverridesDefaultCheck(t *testing.T) {
+	// Summary and Text are both empty, which would fail the default check,
+	// but ValidateFunc should be used instead and can choose to allow it.
+	card := NewMessageCard()
+	card.ValidateFunc = func() error {
+		return nil
+	}
+
+	assert.Equal(t, nil, card.Validate())
+}
+
+func TestIsValidMessageCard(t *testing.T) {
+	valid := NewMessageCard()
+	valid.Text = "hello"
+	ok, err := IsValidMessageCard(valid)
+	assert.True(t, ok)
+	assert.Equal(t, nil, err)
+
+	invalid := NewMessageCard()
+	ok, err = IsValidMessageCard(invalid)
+	assert.True(t, !ok)
+	assert.True(t, err != nil)
+}
@@ -0,0 +1,278 @@
+package gomsteams
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Default Notifier tuning values. The rate limit defaults reflect
+// Microsoft Teams' documented per-connector throttling: roughly 4
+// requests/second and 1600 requests/hour.
+const (
+	DefaultNotifierWorkers       = 4
+	DefaultNotifierQueueSize     = 100
+	DefaultNotifierRatePerSecond = 4
+	DefaultNotifierBurst         = 4
+	DefaultNotifierRatePerHour   = 1600
+)
+
+var (
+	// ErrNotifierClosed is returned by Enqueue once Close has been called.
+	ErrNotifierClosed = errors.New("notifier: closed")
+	// ErrNotifierQueueFull is returned by Enqueue when the worker pool's
+	// queue is full; the message is dropped rather than blocking the caller.
+	ErrNotifierQueueFull = errors.New("notifier: queue full, message dropped")
+)
+
+// NotifierOptions - options for a Notifier
+type NotifierOptions struct {
+	// Workers is the number of goroutines dispatching enqueued messages.
+	// Defaults to DefaultNotifierWorkers.
+	Workers int
+	// QueueSize bounds how many enqueued messages may be pending dispatch
+	// before Enqueue starts dropping them. Defaults to DefaultNotifierQueueSize.
+	QueueSize int
+	// RatePerSecond is the per-webhook-URL request rate limit. Defaults to
+	// DefaultNotifierRatePerSecond.
+	RatePerSecond float64
+	// Burst is the per-webhook-URL token bucket burst size. Defaults to
+	// DefaultNotifierBurst.
+	Burst float64
+	// RatePerHour is the per-webhook-URL hourly request rate limit.
+	// Defaults to DefaultNotifierRatePerHour.
+	RatePerHour float64
+	// MaxAttempts is the total number of times a message is dispatched; a
+	// failed Client.Send is requeued until this many attempts have been made
+	// (Client.Send already retries transient failures itself; this covers
+	// failures Client gives up on, e.g. a full outage). Defaults to 1,
+	// meaning no notifier-level requeue.
+	MaxAttempts int
+	// Results, when set, receives a NotifyResult for every dispatched
+	// message. The Notifier never blocks on it: if it is full, the result
+	// is dropped (dropping a result does not affect the message's delivery).
+	Results chan<- NotifyResult
+}
+
+// NotifyResult describes the outcome of a single dispatched message.
+type NotifyResult struct {
+	WebhookURL string
+	Message    MessageCard
+	Err        error
+	Latency    time.Duration
+	Attempts   int
+}
+
+// NotifierMetrics holds a point-in-time snapshot of a Notifier's counters.
+type NotifierMetrics struct {
+	Sent    int64
+	Retried int64
+	Dropped int64
+}
+
+// notifyJob is a single message queued for dispatch.
+type notifyJob struct {
+	webhookURL string
+	message    MessageCard
+	attempt    int
+}
+
+// Notifier is a higher-level sender built on top of Client. It accepts
+// messages via Enqueue and dispatches them through a bounded worker pool,
+// applying a per-webhook-URL token-bucket rate limit so that high-volume
+// callers (log/alert pipelines) don't need to build their own goroutine
+// pool and limiter on top of Client.
+type Notifier struct {
+	client  *Client
+	options NotifierOptions
+
+	jobs   chan notifyJob
+	wg     sync.WaitGroup
+	closed chan struct{}
+	once   sync.Once
+
+	limitersMu sync.Mutex
+	limiters   map[string]*rateLimiter
+
+	sent    int64
+	retried int64
+	dropped int64
+}
+
+// NewNotifier - create a new Notifier dispatching through client.
+func NewNotifier(client *Client, options NotifierOptions) *Notifier {
+	if options.Workers <= 0 {
+		options.Workers = DefaultNotifierWorkers
+	}
+	if options.QueueSize <= 0 {
+		options.QueueSize = DefaultNotifierQueueSize
+	}
+	if options.RatePerSecond <= 0 {
+		options.RatePerSecond = DefaultNotifierRatePerSecond
+	}
+	if options.Burst <= 0 {
+		options.Burst = DefaultNotifierBurst
+	}
+	if options.RatePerHour <= 0 {
+		options.RatePerHour = DefaultNotifierRatePerHour
+	}
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = 1
+	}
+
+	n := &Notifier{
+		client:   client,
+		options:  options,
+		jobs:     make(chan notifyJob, options.QueueSize),
+		closed:   make(chan struct{}),
+		limiters: make(map[string]*rateLimiter),
+	}
+
+	for i := 0; i < options.Workers; i++ {
+		n.wg.Add(1)
+		go n.worker()
+	}
+
+	return n
+}
+
+// Enqueue - queues a message for dispatch to webhookURL. Returns
+// ErrNotifierClosed if Close has been called, or ErrNotifierQueueFull if
+// the worker pool's queue is full; either way the message is dropped
+// rather than blocking the caller.
+func (n *Notifier) Enqueue(webhookURL string, message MessageCard) error {
+	select {
+	case <-n.closed:
+		return ErrNotifierClosed
+	default:
+	}
+
+	if err := n.enqueue(notifyJob{webhookURL: webhookURL, message: message, attempt: 1}); err != nil {
+		atomic.AddInt64(&n.dropped, 1)
+		return err
+	}
+
+	return nil
+}
+
+// enqueue pushes job onto the queue. It does not count drops: callers are
+// responsible for that, since a failed requeue from dispatch must only be
+// counted once, alongside the attempt it belongs to.
+func (n *Notifier) enqueue(job notifyJob) error {
+	select {
+	case n.jobs <- job:
+		return nil
+	default:
+		return ErrNotifierQueueFull
+	}
+}
+
+// Close stops accepting new messages, drains the ones already queued, and
+// waits for in-flight messages to finish, returning early with ctx's error
+// if it is cancelled or its deadline expires first. Enqueue returns
+// ErrNotifierClosed once Close has started.
+func (n *Notifier) Close(ctx context.Context) error {
+	n.once.Do(func() {
+		close(n.closed)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		n.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Metrics returns a snapshot of this Notifier's delivery counters.
+func (n *Notifier) Metrics() NotifierMetrics {
+	return NotifierMetrics{
+		Sent:    atomic.LoadInt64(&n.sent),
+		Retried: atomic.LoadInt64(&n.retried),
+		Dropped: atomic.LoadInt64(&n.dropped),
+	}
+}
+
+// worker drains jobs until Close is signaled and no job is immediately
+// available; it never closes the jobs channel itself, since dispatch may
+// still be requeuing retries into it.
+func (n *Notifier) worker() {
+	defer n.wg.Done()
+
+	for {
+		select {
+		case job := <-n.jobs:
+			n.dispatch(job)
+		default:
+			select {
+			case job := <-n.jobs:
+				n.dispatch(job)
+			case <-n.closed:
+				return
+			}
+		}
+	}
+}
+
+func (n *Notifier) dispatch(job notifyJob) {
+	n.limiterFor(job.webhookURL).wait()
+
+	start := time.Now()
+	err := n.client.Send(job.webhookURL, job.message)
+	latency := time.Since(start)
+
+	if err == nil {
+		atomic.AddInt64(&n.sent, 1)
+		n.deliver(NotifyResult{WebhookURL: job.webhookURL, Message: job.message, Latency: latency, Attempts: job.attempt})
+		return
+	}
+
+	if job.attempt < n.options.MaxAttempts {
+		select {
+		case <-n.closed:
+			// Don't requeue once Close has started draining.
+		default:
+			if requeueErr := n.enqueue(notifyJob{webhookURL: job.webhookURL, message: job.message, attempt: job.attempt + 1}); requeueErr == nil {
+				atomic.AddInt64(&n.retried, 1)
+				return
+			}
+		}
+	}
+
+	atomic.AddInt64(&n.dropped, 1)
+	n.deliver(NotifyResult{WebhookURL: job.webhookURL, Message: job.message, Err: err, Latency: latency, Attempts: job.attempt})
+}
+
+func (n *Notifier) deliver(result NotifyResult) {
+	if n.options.Results == nil {
+		return
+	}
+
+	select {
+	case n.options.Results <- result:
+	default:
+		// Caller isn't draining Results fast enough; drop the result
+		// rather than block a worker. The message's delivery is unaffected.
+	}
+}
+
+func (n *Notifier) limiterFor(webhookURL string) *rateLimiter {
+	n.limitersMu.Lock()
+	defer n.limitersMu.Unlock()
+
+	limiter, ok := n.limiters[webhookURL]
+	if !ok {
+		limiter = newRateLimiter(n.options.RatePerSecond, n.options.Burst, n.options.RatePerHour)
+		n.limiters[webhookURL] = limiter
+	}
+
+	return limiter
+}
@@ -0,0 +1,181 @@
+package gomsteams
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fastLimitOptions(maxAttempts int, results chan<- NotifyResult) NotifierOptions {
+	return NotifierOptions{
+		Workers:       4,
+		QueueSize:     100,
+		RatePerSecond: 1e6,
+		Burst:         1e6,
+		RatePerHour:   1e6,
+		MaxAttempts:   maxAttempts,
+		Results:       results,
+	}
+}
+
+func alwaysOKClient() *Client {
+	httpClient := NewTestClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Body: http.NoBody}, nil
+	})
+	return &Client{httpClient: httpClient, options: &Options{Timeout: 60 * time.Second}}
+}
+
+func alwaysFailingClient() *Client {
+	httpClient := NewTestClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusInternalServerError, Header: make(http.Header), Body: http.NoBody}, nil
+	})
+	return &Client{httpClient: httpClient, options: &Options{Timeout: 60 * time.Second, MaxRetries: 0}}
+}
+
+func TestNotifierEnqueueAndDrain(t *testing.T) {
+	const messageCount = 50
+
+	results := make(chan NotifyResult, messageCount)
+	n := NewNotifier(alwaysOKClient(), fastLimitOptions(1, results))
+
+	msg := NewMessageCard()
+	msg.Text = "hello"
+	for i := 0; i < messageCount; i++ {
+		assert.Equal(t, nil, n.Enqueue("https://outlook.office.com/webhook/xxx", msg))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.Equal(t, nil, n.Close(ctx))
+
+	assert.Equal(t, messageCount, len(results))
+	for i := 0; i < messageCount; i++ {
+		result := <-results
+		assert.Equal(t, nil, result.Err)
+		assert.Equal(t, 1, result.Attempts)
+	}
+
+	metrics := n.Metrics()
+	assert.Equal(t, int64(messageCount), metrics.Sent)
+	assert.Equal(t, int64(0), metrics.Retried)
+	assert.Equal(t, int64(0), metrics.Dropped)
+}
+
+func TestNotifierRetriesUntilMaxAttemptsThenDrops(t *testing.T) {
+	results := make(chan NotifyResult, 1)
+	n := NewNotifier(alwaysFailingClient(), fastLimitOptions(3, results))
+
+	msg := NewMessageCard()
+	msg.Text = "hello"
+	assert.Equal(t, nil, n.Enqueue("https://outlook.office.com/webhook/xxx", msg))
+
+	// Wait for the message to exhaust its retries before calling Close:
+	// Close stops further requeues as soon as it starts draining, so
+	// reading the terminal result first avoids racing with that cutoff.
+	var result NotifyResult
+	select {
+	case result = <-results:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the notifier to exhaust retries")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.Equal(t, nil, n.Close(ctx))
+
+	assert.True(t, result.Err != nil, "expected the final delivery attempt to report an error")
+	assert.Equal(t, 3, result.Attempts)
+
+	metrics := n.Metrics()
+	assert.Equal(t, int64(0), metrics.Sent)
+	assert.Equal(t, int64(2), metrics.Retried)
+	assert.Equal(t, int64(1), metrics.Dropped)
+}
+
+func TestNotifierDropsWhenQueueFull(t *testing.T) {
+	n := &Notifier{
+		client:   alwaysOKClient(),
+		options:  NotifierOptions{MaxAttempts: 1},
+		jobs:     make(chan notifyJob, 1),
+		closed:   make(chan struct{}),
+		limiters: make(map[string]*rateLimiter),
+	}
+	defer close(n.closed)
+
+	msg := NewMessageCard()
+	msg.Text = "hello"
+
+	assert.Equal(t, nil, n.Enqueue("https://outlook.office.com/webhook/xxx", msg))
+	err := n.Enqueue("https://outlook.office.com/webhook/xxx", msg)
+	assert.Equal(t, ErrNotifierQueueFull, err)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&n.dropped))
+}
+
+func TestNotifierDispatchCountsFailedRequeueAsDroppedOnce(t *testing.T) {
+	n := &Notifier{
+		client:   alwaysFailingClient(),
+		options:  NotifierOptions{MaxAttempts: 3},
+		jobs:     make(chan notifyJob, 1),
+		closed:   make(chan struct{}),
+		limiters: make(map[string]*rateLimiter),
+	}
+	defer close(n.closed)
+
+	// Fill the queue so the requeue dispatch attempts below can't succeed.
+	n.jobs <- notifyJob{webhookURL: "https://outlook.office.com/webhook/filler", attempt: 1}
+
+	msg := NewMessageCard()
+	msg.Text = "hello"
+	n.dispatch(notifyJob{webhookURL: "https://outlook.office.com/webhook/xxx", message: msg, attempt: 1})
+
+	metrics := n.Metrics()
+	assert.Equal(t, int64(0), metrics.Retried)
+	assert.Equal(t, int64(1), metrics.Dropped)
+}
+
+func TestNotifierEnqueueAfterCloseReturnsClosedError(t *testing.T) {
+	n := NewNotifier(alwaysOKClient(), fastLimitOptions(1, nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.Equal(t, nil, n.Close(ctx))
+
+	msg := NewMessageCard()
+	msg.Text = "hello"
+	err := n.Enqueue("https://outlook.office.com/webhook/xxx", msg)
+	assert.Equal(t, ErrNotifierClosed, err)
+}
+
+func TestNotifierConcurrentEnqueueAndClose(t *testing.T) {
+	n := NewNotifier(alwaysOKClient(), fastLimitOptions(1, nil))
+
+	msg := NewMessageCard()
+	msg.Text = "hello"
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				_ = n.Enqueue("https://outlook.office.com/webhook/xxx", msg)
+			}
+		}()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := n.Close(ctx)
+
+	wg.Wait()
+	assert.Equal(t, nil, err)
+
+	// Enqueue after Close has returned must consistently report closed,
+	// never panic or block.
+	assert.Equal(t, ErrNotifierClosed, n.Enqueue("https://outlook.office.com/webhook/xxx", msg))
+}
@@ -0,0 +1,88 @@
+package gomsteams
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimiter combines a per-second and a per-hour token bucket, since
+// Teams throttles connectors on both windows simultaneously.
+type rateLimiter struct {
+	perSecond *tokenBucket
+	perHour   *tokenBucket
+}
+
+// newRateLimiter - create a rate limiter allowing ratePerSecond requests per
+// second (with the given burst) and ratePerHour requests per hour.
+func newRateLimiter(ratePerSecond, burst, ratePerHour float64) *rateLimiter {
+	return &rateLimiter{
+		perSecond: newTokenBucket(ratePerSecond, burst, time.Second),
+		perHour:   newTokenBucket(ratePerHour, ratePerHour, time.Hour),
+	}
+}
+
+// wait blocks until both the per-second and per-hour buckets have a token
+// available.
+func (rl *rateLimiter) wait() {
+	rl.perSecond.wait()
+	rl.perHour.wait()
+}
+
+// tokenBucket is a simple token-bucket rate limiter refilled lazily based
+// on elapsed wall-clock time.
+type tokenBucket struct {
+	mu     sync.Mutex
+	tokens float64
+	max    float64
+	rate   float64 // tokens refilled per `per`
+	per    time.Duration
+	last   time.Time
+}
+
+// newTokenBucket - create a bucket that refills at rate tokens per `per`,
+// holding at most max tokens, starting full.
+func newTokenBucket(rate, max float64, per time.Duration) *tokenBucket {
+	return &tokenBucket{
+		tokens: max,
+		max:    max,
+		rate:   rate,
+		per:    per,
+		last:   time.Now(),
+	}
+}
+
+// wait blocks the calling goroutine until a token is available, consuming
+// one in the process.
+func (b *tokenBucket) wait() {
+	for {
+		d := b.reserve()
+		if d <= 0 {
+			return
+		}
+		time.Sleep(d)
+	}
+}
+
+// reserve consumes a token if one is available and returns 0, otherwise
+// returns how long the caller should sleep before trying again.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	elapsed := now.Sub(b.last)
+	b.last = now
+
+	b.tokens += elapsed.Seconds() / b.per.Seconds() * b.rate
+	if b.tokens > b.max {
+		b.tokens = b.max
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+
+	missing := 1 - b.tokens
+	return time.Duration(missing / b.rate * float64(b.per))
+}
@@ -0,0 +1,95 @@
+package gomsteams
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// isRetryableStatusCode reports whether resp status codes in this set
+// indicate a transient failure worth retrying.
+func isRetryableStatusCode(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// backoffDelay computes an exponential backoff delay with full jitter for
+// the given retry attempt (0-indexed), bounded by RetryMaxDelay.
+func (c *Client) backoffDelay(attempt int) time.Duration {
+	maxDelay := c.options.RetryBaseDelay << uint(attempt)
+	if maxDelay <= 0 || maxDelay > c.options.RetryMaxDelay {
+		maxDelay = c.options.RetryMaxDelay
+	}
+
+	return time.Duration(rand.Int63n(int64(maxDelay) + 1))
+}
+
+// parseRetryAfter parses a Retry-After header value, which per RFC 7231 is
+// either a number of delta-seconds or an HTTP-date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		delay := time.Until(t)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// sleepContext sleeps for d, returning early with ctx.Err() if ctx is
+// cancelled first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// cloneRequestForRetry returns a copy of r with its body rewound, suitable
+// for re-issuing after a retryable failure.
+func cloneRequestForRetry(r *http.Request) (*http.Request, error) {
+	req := r.Clone(r.Context())
+
+	if r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("failed to rewind request body for retry: %v", err)
+		}
+		req.Body = body
+	}
+
+	return req, nil
+}
@@ -0,0 +1,222 @@
+package gomsteams
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	future := time.Now().Add(2 * time.Minute).UTC().Format(http.TimeFormat)
+	past := time.Now().Add(-2 * time.Minute).UTC().Format(http.TimeFormat)
+
+	var tests = []struct {
+		name    string
+		value   string
+		wantOK  bool
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{name: "empty", value: "", wantOK: false},
+		{name: "delta-seconds", value: "120", wantOK: true, wantMin: 120 * time.Second, wantMax: 120 * time.Second},
+		{name: "negative delta-seconds", value: "-5", wantOK: false},
+		{name: "not a number or date", value: "soon", wantOK: false},
+		{name: "future HTTP-date", value: future, wantOK: true, wantMin: 0, wantMax: 2 * time.Minute},
+		{name: "past HTTP-date clamps to zero", value: past, wantOK: true, wantMin: 0, wantMax: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d, ok := parseRetryAfter(test.value)
+			assert.Equal(t, test.wantOK, ok)
+			if test.wantOK {
+				assert.True(t, d >= test.wantMin && d <= test.wantMax,
+					"duration %v out of expected range [%v, %v]", d, test.wantMin, test.wantMax)
+			}
+		})
+	}
+}
+
+func TestIsRetryableStatusCode(t *testing.T) {
+	var tests = []struct {
+		statusCode int
+		retryable  bool
+	}{
+		{http.StatusTooManyRequests, true},
+		{http.StatusInternalServerError, true},
+		{http.StatusBadGateway, true},
+		{http.StatusServiceUnavailable, true},
+		{http.StatusGatewayTimeout, true},
+		{http.StatusOK, false},
+		{http.StatusBadRequest, false},
+		{http.StatusUnauthorized, false},
+		{http.StatusForbidden, false},
+		{http.StatusNotFound, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.retryable, isRetryableStatusCode(test.statusCode))
+	}
+}
+
+func retryTestClient(responder func(attempt int) (*http.Response, error)) (*Client, *int32) {
+	var attempts int32
+
+	httpClient := NewTestClient(func(req *http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&attempts, 1)
+		return responder(int(n))
+	})
+
+	opts := Options{
+		Timeout:        60 * time.Second,
+		MaxRetries:     3,
+		RetryBaseDelay: time.Millisecond,
+		RetryMaxDelay:  5 * time.Millisecond,
+	}
+
+	return &Client{httpClient: httpClient, options: &opts}, &attempts
+}
+
+func TestClientSendRetriesRetryableStatusThenSucceeds(t *testing.T) {
+	c, attempts := retryTestClient(func(attempt int) (*http.Response, error) {
+		if attempt < 3 {
+			return &http.Response{StatusCode: http.StatusServiceUnavailable, Header: make(http.Header), Body: http.NoBody}, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Body: http.NoBody}, nil
+	})
+
+	msg := NewMessageCard()
+	msg.Text = "hello"
+	err := c.Send("https://outlook.office.com/webhook/xxx", msg)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(attempts))
+}
+
+func TestClientSendExhaustsRetriesAndReturnsError(t *testing.T) {
+	c, attempts := retryTestClient(func(attempt int) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusInternalServerError, Header: make(http.Header), Body: http.NoBody}, nil
+	})
+
+	msg := NewMessageCard()
+	msg.Text = "hello"
+	err := c.Send("https://outlook.office.com/webhook/xxx", msg)
+
+	assert.True(t, err != nil, "expected an error once retries are exhausted")
+	// c.options.MaxRetries is 3, so the initial attempt plus 3 retries = 4 calls.
+	assert.Equal(t, int32(4), atomic.LoadInt32(attempts))
+}
+
+func TestClientSendDoesNotRetryNonRetryableStatus(t *testing.T) {
+	c, attempts := retryTestClient(func(attempt int) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusBadRequest, Header: make(http.Header), Body: http.NoBody}, nil
+	})
+
+	msg := NewMessageCard()
+	msg.Text = "hello"
+	err := c.Send("https://outlook.office.com/webhook/xxx", msg)
+
+	assert.True(t, err != nil, "expected an error for a non-retryable status")
+	assert.Equal(t, int32(1), atomic.LoadInt32(attempts))
+}
+
+func TestClientSendHonorsRetryAfterOverBackoff(t *testing.T) {
+	var attempts int32
+	httpClient := NewTestClient(func(req *http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&attempts, 1)
+		if n == 1 {
+			header := make(http.Header)
+			header.Set("Retry-After", "0")
+			return &http.Response{StatusCode: http.StatusTooManyRequests, Header: header, Body: http.NoBody}, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Body: http.NoBody}, nil
+	})
+
+	opts := Options{
+		Timeout:        60 * time.Second,
+		MaxRetries:     1,
+		RetryBaseDelay: 200 * time.Millisecond,
+		RetryMaxDelay:  200 * time.Millisecond,
+	}
+	c := &Client{httpClient: httpClient, options: &opts}
+
+	msg := NewMessageCard()
+	msg.Text = "hello"
+
+	start := time.Now()
+	err := c.Send("https://outlook.office.com/webhook/xxx", msg)
+	elapsed := time.Since(start)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+	assert.True(t, elapsed < 100*time.Millisecond,
+		"Retry-After: 0 should bypass the configured backoff delay, took %v", elapsed)
+}
+
+func TestClientSendContextCancelAbortsPendingRetrySleep(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Header: make(http.Header), Body: http.NoBody}, nil
+	})
+
+	opts := Options{
+		Timeout:        60 * time.Second,
+		MaxRetries:     5,
+		RetryBaseDelay: 2 * time.Second,
+		RetryMaxDelay:  2 * time.Second,
+	}
+	c := &Client{httpClient: httpClient, options: &opts}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+
+	msg := NewMessageCard()
+	msg.Text = "hello"
+
+	start := time.Now()
+	err := c.SendWithContext(ctx, "https://outlook.office.com/webhook/xxx", msg)
+	elapsed := time.Since(start)
+
+	assert.True(t, errors.Is(err, context.Canceled), "expected the pending retry sleep to be aborted by context cancellation")
+	assert.True(t, elapsed < time.Second, "cancellation should abort the sleep well before the 2s backoff, took %v", elapsed)
+}
+
+func TestClientSendReclonesRequestBodyOnRetry(t *testing.T) {
+	var bodies [][]byte
+
+	httpClient := NewTestClient(func(req *http.Request) (*http.Response, error) {
+		body, err := io.ReadAll(req.Body)
+		assert.Equal(t, nil, err)
+		bodies = append(bodies, body)
+
+		if len(bodies) < 2 {
+			return &http.Response{StatusCode: http.StatusServiceUnavailable, Header: make(http.Header), Body: http.NoBody}, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Body: http.NoBody}, nil
+	})
+
+	opts := Options{
+		Timeout:        60 * time.Second,
+		MaxRetries:     1,
+		RetryBaseDelay: time.Millisecond,
+		RetryMaxDelay:  time.Millisecond,
+	}
+	c := &Client{httpClient: httpClient, options: &opts}
+
+	msg := NewMessageCard()
+	msg.Text = "hello"
+	err := c.Send("https://outlook.office.com/webhook/xxx", msg)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(bodies))
+	assert.True(t, len(bodies[0]) > 0, "first attempt should have a non-empty body")
+	assert.Equal(t, string(bodies[0]), string(bodies[1]))
+}
@@ -12,6 +12,7 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -21,6 +22,16 @@ const (
 	WebhookURLOffice365Prefix = "https://outlook.office365.com"
 )
 
+// Known webhook URL host suffixes for the Power Automate / Workflows
+// endpoints Microsoft has migrated incoming webhooks to. Unlike the legacy
+// connector prefixes above, these are tenant-scoped: any subdomain is
+// accepted, e.g. "https://xyz123.webhook.office.com/...".
+const (
+	WebhookURLLogicAzureComDomain    = "logic.azure.com"
+	WebhookURLLogicAzureUSDomain     = "logic.azure.us"
+	WebhookURLWebhookOfficeComDomain = "webhook.office.com"
+)
+
 var (
 	// ErrUserAccessDenied access denied error
 	ErrUserAccessDenied = errors.New("you do not have access to the requested resource")
@@ -28,6 +39,10 @@ var (
 	ErrNotFound = errors.New("the requested resource not found")
 	// ErrTooManyRequests error too many requests
 	ErrTooManyRequests = errors.New("you have exceeded throttle")
+	// ErrWebhookURLUnexpected is returned when a webhook URL matches
+	// neither a known Microsoft prefix/host nor an allow-listed one. Use
+	// errors.Is to check for it instead of type-asserting on *url.Error.
+	ErrWebhookURLUnexpected = errors.New("webhook URL does not match any known or allowed prefix")
 )
 
 // API - interface of MS Teams notify
@@ -39,12 +54,41 @@ type API interface {
 type Options struct {
 	Timeout time.Duration
 	Verbose bool
+
+	// MaxRetries is the number of times a request is retried after a
+	// transient failure (network error, 429, 500, 502, 503, 504) before
+	// giving up. Defaults to 0 (no retries), preserving prior behavior.
+	MaxRetries int
+	// RetryBaseDelay is the base delay used to compute exponential backoff
+	// between retries. Defaults to 500ms.
+	RetryBaseDelay time.Duration
+	// RetryMaxDelay caps the computed backoff delay, and also caps how long
+	// a server-provided Retry-After is honored for. Defaults to 30s.
+	RetryMaxDelay time.Duration
+
+	// HTTPClient, when set, is used instead of the client NewClient would
+	// otherwise build, letting callers inject a custom transport for
+	// proxies, mTLS, corporate CA bundles, or tracing/metrics
+	// round-trippers. Timeout is ignored when HTTPClient is set; configure
+	// it on the provided client instead.
+	HTTPClient *http.Client
+
+	// AllowedWebhookURLPrefixes lists additional trusted webhook URL
+	// prefixes to accept beyond the built-in Microsoft ones, e.g. for
+	// self-hosted proxies or hosts this package doesn't yet know about.
+	// See also Client.AddWebhookURLPrefix.
+	AllowedWebhookURLPrefixes []string
 }
 
 // Client MS teams Http client
 type Client struct {
 	httpClient *http.Client
 	options    *Options
+
+	// prefixMu guards options.AllowedWebhookURLPrefixes, which IsValidWebhookURL
+	// reads and AddWebhookURLPrefix mutates; Notifier drives Send from a worker
+	// pool, so both can happen concurrently.
+	prefixMu sync.RWMutex
 }
 
 // NewClient create a brand new client for MS Teams notify
@@ -53,11 +97,24 @@ func NewClient(options Options) *Client {
 		options.Timeout = 30 * time.Second
 	}
 
-	teamsClient := &Client{
-		httpClient: &http.Client{
+	if options.RetryBaseDelay <= 0 {
+		options.RetryBaseDelay = 500 * time.Millisecond
+	}
+
+	if options.RetryMaxDelay <= 0 {
+		options.RetryMaxDelay = 30 * time.Second
+	}
+
+	httpClient := options.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{
 			Timeout: options.Timeout,
-		},
-		options: &options,
+		}
+	}
+
+	teamsClient := &Client{
+		httpClient: httpClient,
+		options:    &options,
 	}
 
 	return teamsClient
@@ -89,35 +146,70 @@ func (c *Client) newRequest(ctx context.Context, method, reqURL string, payload
 	return req, nil
 }
 
+// do executes r, retrying transient failures (network errors, 429, 500,
+// 502, 503, 504) up to options.MaxRetries times with exponential backoff +
+// jitter, honoring a Retry-After response header when present.
 func (c *Client) do(r *http.Request) (*http.Response, error) {
-	resp, err := c.httpClient.Do(r)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make request [%s:%s]: %v", r.Method, r.URL.String(), err)
-	}
+	for attempt := 0; ; attempt++ {
+		req := r
+		if attempt > 0 {
+			retryReq, err := cloneRequestForRetry(r)
+			if err != nil {
+				return nil, err
+			}
+			req = retryReq
+		}
 
-	if c.options.Verbose {
-		body, _ := httputil.DumpResponse(resp, true)
-		log.Println(string(body))
-	}
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			if attempt >= c.options.MaxRetries {
+				return nil, fmt.Errorf("failed to make request [%s:%s]: %v", r.Method, r.URL.String(), err)
+			}
+			if err := sleepContext(r.Context(), c.backoffDelay(attempt)); err != nil {
+				return nil, err
+			}
+			continue
+		}
 
-	switch resp.StatusCode {
-	case http.StatusOK,
-		http.StatusCreated,
-		http.StatusNoContent:
-		return resp, nil
-	}
+		if c.options.Verbose {
+			body, _ := httputil.DumpResponse(resp, true)
+			log.Println(string(body))
+		}
 
-	switch resp.StatusCode {
-	case http.StatusNotFound:
-		return nil, ErrNotFound
-	case http.StatusUnauthorized,
-		http.StatusForbidden:
-		return nil, ErrUserAccessDenied
-	case http.StatusTooManyRequests:
-		return nil, ErrTooManyRequests
-	}
+		switch resp.StatusCode {
+		case http.StatusOK,
+			http.StatusCreated,
+			http.StatusNoContent:
+			return resp, nil
+		}
+
+		if isRetryableStatusCode(resp.StatusCode) && attempt < c.options.MaxRetries {
+			delay := c.backoffDelay(attempt)
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				delay = retryAfter
+				if delay > c.options.RetryMaxDelay {
+					delay = c.options.RetryMaxDelay
+				}
+			}
+			resp.Body.Close()
+			if err := sleepContext(r.Context(), delay); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		switch resp.StatusCode {
+		case http.StatusNotFound:
+			return nil, ErrNotFound
+		case http.StatusUnauthorized,
+			http.StatusForbidden:
+			return nil, ErrUserAccessDenied
+		case http.StatusTooManyRequests:
+			return nil, ErrTooManyRequests
+		}
 
-	return nil, fmt.Errorf("failed to do request, %d status code received", resp.StatusCode)
+		return nil, fmt.Errorf("failed to do request, %d status code received", resp.StatusCode)
+	}
 }
 
 func (c *Client) doRequest(r *http.Request, v interface{}) error {
@@ -152,13 +244,22 @@ func (c *Client) doRequest(r *http.Request, v interface{}) error {
 
 // Send - will post a notification to MS Teams webhook URL
 func (c *Client) Send(webhookURL string, webhookMessage MessageCard) error {
+	return c.SendWithContext(context.Background(), webhookURL, webhookMessage)
+}
+
+// SendWithContext - will post a notification to MS Teams webhook URL,
+// aborting the request if ctx is cancelled or its deadline is exceeded.
+func (c *Client) SendWithContext(ctx context.Context, webhookURL string, webhookMessage MessageCard) error {
 	// Validate input data
-	if valid, err := IsValidInput(webhookMessage, webhookURL); !valid {
+	if valid, err := c.IsValidWebhookURL(webhookURL); !valid {
+		return err
+	}
+	if valid, err := IsValidMessageCard(webhookMessage); !valid {
 		return err
 	}
 
 	// make new request
-	req, err := c.newRequest(context.Background(), http.MethodPost, webhookURL, webhookMessage)
+	req, err := c.newRequest(ctx, http.MethodPost, webhookURL, webhookMessage)
 	if err != nil {
 		return fmt.Errorf("error in creating request, %s", err)
 	}
@@ -174,57 +275,97 @@ func (c *Client) Send(webhookURL string, webhookMessage MessageCard) error {
 
 // helper --------------------------------------------------------------------------------------------------------------
 
-// IsValidInput is a validation "wrapper" function. This function is intended
-// to run current validation checks and offer easy extensibility for future
-// validation requirements.
-func IsValidInput(webhookMessage MessageCard, webhookURL string) (bool, error) {
-	// validate url
-	if valid, err := IsValidWebhookURL(webhookURL); !valid {
-		return false, err
+// IsValidWebhookURL performs validation checks on the webhook URL used to
+// submit messages to Microsoft Teams, accepting both the legacy connector
+// prefixes and the tenant-scoped Power Automate / Workflows hosts. Returns
+// an error wrapping ErrWebhookURLUnexpected if webhookURL matches neither;
+// a webhookURL that cannot be parsed yields a parse error that does not
+// wrap ErrWebhookURLUnexpected. To also accept caller-registered prefixes, use Client.IsValidWebhookURL.
+func IsValidWebhookURL(webhookURL string) (bool, error) {
+	switch {
+	case strings.HasPrefix(webhookURL, WebhookURLOfficeComPrefix):
+		return true, nil
+	case strings.HasPrefix(webhookURL, WebhookURLOffice365Prefix):
+		return true, nil
 	}
 
-	// validate message
-	if valid, err := IsValidMessageCard(webhookMessage); !valid {
-		return false, err
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return false, fmt.Errorf(
+			"unable to parse webhook URL %q: %v",
+			webhookURL,
+			err,
+		)
 	}
 
-	return true, nil
+	switch {
+	case hasHostSuffix(u.Host, WebhookURLLogicAzureComDomain):
+		return true, nil
+	case hasHostSuffix(u.Host, WebhookURLLogicAzureUSDomain):
+		return true, nil
+	case hasHostSuffix(u.Host, WebhookURLWebhookOfficeComDomain):
+		return true, nil
+	}
+
+	userProvidedWebhookURLPrefix := u.Scheme + "://" + u.Host
+
+	return false, fmt.Errorf("%w: got %q, expected one of %q, %q, or a tenant-scoped host under %q, %q, %q",
+		ErrWebhookURLUnexpected,
+		userProvidedWebhookURLPrefix,
+		WebhookURLOfficeComPrefix,
+		WebhookURLOffice365Prefix,
+		WebhookURLLogicAzureComDomain,
+		WebhookURLLogicAzureUSDomain,
+		WebhookURLWebhookOfficeComDomain,
+	)
 }
 
-// IsValidWebhookURL performs validation checks on the webhook URL used to submit messages to Microsoft Teams.
-func IsValidWebhookURL(webhookURL string) (bool, error) {
-	switch {
-	case strings.HasPrefix(webhookURL, WebhookURLOfficeComPrefix):
-	case strings.HasPrefix(webhookURL, WebhookURLOffice365Prefix):
-	default:
-		u, err := url.Parse(webhookURL)
-		if err != nil {
-			return false, fmt.Errorf(
-				"unable to parse webhook URL %q: %v",
-				webhookURL,
-				err,
-			)
+// hasHostSuffix reports whether host is domain itself or a subdomain of it.
+func hasHostSuffix(host, domain string) bool {
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
+
+// IsValidWebhookURL performs the same checks as the package-level
+// IsValidWebhookURL, additionally accepting any prefix registered via
+// Options.AllowedWebhookURLPrefixes or AddWebhookURLPrefix.
+func (c *Client) IsValidWebhookURL(webhookURL string) (bool, error) {
+	if valid, err := IsValidWebhookURL(webhookURL); valid {
+		return true, nil
+	} else {
+		c.prefixMu.RLock()
+		prefixes := c.options.AllowedWebhookURLPrefixes
+		c.prefixMu.RUnlock()
+
+		if len(prefixes) == 0 {
+			return false, err
 		}
-		userProvidedWebhookURLPrefix := u.Scheme + "://" + u.Host
 
-		return false, &url.Error{Err: fmt.Errorf("webhook URL does not contain expected prefix; got %q, expected one of %q or %q",
-			userProvidedWebhookURLPrefix,
-			WebhookURLOfficeComPrefix,
-			WebhookURLOffice365Prefix),
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(webhookURL, prefix) {
+				return true, nil
+			}
 		}
+
+		return false, err
 	}
+}
 
-	return true, nil
+// AddWebhookURLPrefix registers an additional trusted webhook URL prefix
+// for this client, beyond the built-in Microsoft ones. Safe to call
+// concurrently with Send/SendWithContext.
+func (c *Client) AddWebhookURLPrefix(prefix string) {
+	c.prefixMu.Lock()
+	defer c.prefixMu.Unlock()
+	c.options.AllowedWebhookURLPrefixes = append(c.options.AllowedWebhookURLPrefixes, prefix)
 }
 
 // IsValidMessageCard performs validation/checks for known issues with
-// MessageCard values.
+// MessageCard values. It defers to MessageCard.Validate, which falls back
+// to the default "summary or text required" check unless the caller has
+// set MessageCard.ValidateFunc.
 func IsValidMessageCard(webhookMessage MessageCard) (bool, error) {
-	if (webhookMessage.Text == "") && (webhookMessage.Summary == "") {
-		// This scenario results in:
-		// 400 Bad Request
-		// Summary or Text is required.
-		return false, fmt.Errorf("invalid message card: summary or text field is required")
+	if err := webhookMessage.Validate(); err != nil {
+		return false, err
 	}
 
 	return true, nil
@@ -0,0 +1,63 @@
+package gomsteams
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vikramarsid/go-ms-teams/adaptivecard"
+)
+
+func TestClientSendWithContextAbortsOnCancel(t *testing.T) {
+	simpleMsgCard := NewMessageCard()
+	simpleMsgCard.Text = "Hello World"
+
+	client := NewTestClient(func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	})
+
+	opts := Options{Timeout: 60 * time.Second}
+	c := &Client{httpClient: client, options: &opts}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := c.SendWithContext(ctx, "https://outlook.office.com/webhook/xxx", simpleMsgCard)
+	assert.True(t, err != nil, "expected an error when context is already cancelled")
+}
+
+func TestClientSendAdaptiveWithContextAbortsOnCancel(t *testing.T) {
+	client := NewTestClient(func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	})
+
+	opts := Options{Timeout: 60 * time.Second}
+	c := &Client{httpClient: client, options: &opts}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := c.SendAdaptiveWithContext(ctx, "https://outlook.office.com/webhook/xxx", *adaptivecard.NewCard(""))
+	assert.True(t, err != nil, "expected an error when context is already cancelled")
+}
+
+func TestNewClientUsesInjectedHTTPClient(t *testing.T) {
+	injected := NewTestClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: 200, Header: make(http.Header)}, nil
+	})
+
+	client := NewClient(Options{HTTPClient: injected})
+
+	assert.True(t, client.httpClient == injected, "NewClient should use the injected HTTPClient instead of building its own")
+}
+
+func TestNewClientBuildsDefaultHTTPClientWhenNoneInjected(t *testing.T) {
+	client := NewClient(Options{Timeout: 5 * time.Second})
+
+	assert.True(t, client.httpClient != nil, "NewClient should build a default http.Client")
+	assert.Equal(t, 5*time.Second, client.httpClient.Timeout)
+}
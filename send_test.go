@@ -3,7 +3,6 @@ package gomsteams
 import (
 	"errors"
 	"net/http"
-	"net/url"
 	"testing"
 	"time"
 
@@ -30,13 +29,13 @@ func TestTeamsClientSend(t *testing.T) {
 		resError  error // httpClient error
 		error     error // method error
 	}{
-		// invalid webhookURL - url.Parse error
+		// invalid webhookURL - unexpected host
 		{
 			reqURL:    "http://",
 			reqMsg:    simpleMsgCard,
 			resStatus: 0,
 			resError:  nil,
-			error:     &url.Error{},
+			error:     ErrWebhookURLUnexpected,
 		},
 		// invalid webhookURL - missing prefix in webhook URL
 		{
@@ -44,7 +43,7 @@ func TestTeamsClientSend(t *testing.T) {
 			reqMsg:    simpleMsgCard,
 			resStatus: 0,
 			resError:  nil,
-			error:     &url.Error{},
+			error:     ErrWebhookURLUnexpected,
 		},
 		// invalid httpClient.Do call
 		{
@@ -113,7 +112,11 @@ func TestTeamsClientSend(t *testing.T) {
 		c := &Client{httpClient: client, options: &opts}
 
 		err := c.Send(test.reqURL, test.reqMsg)
-		assert.IsType(t, test.error, err)
+		if errors.Is(test.error, ErrWebhookURLUnexpected) {
+			assert.True(t, errors.Is(err, ErrWebhookURLUnexpected))
+		} else {
+			assert.IsType(t, test.error, err)
+		}
 	}
 }
 
@@ -0,0 +1,124 @@
+package gomsteams
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasHostSuffix(t *testing.T) {
+	var tests = []struct {
+		name   string
+		host   string
+		domain string
+		want   bool
+	}{
+		{name: "exact match", host: "webhook.office.com", domain: "webhook.office.com", want: true},
+		{name: "tenant subdomain", host: "xyz123.webhook.office.com", domain: "webhook.office.com", want: true},
+		{name: "nested subdomain", host: "a.b.webhook.office.com", domain: "webhook.office.com", want: true},
+		{name: "unrelated host", host: "evilwebhook.office.com.attacker.test", domain: "webhook.office.com", want: false},
+		{name: "suffix without separator is not a subdomain", host: "notwebhook.office.com", domain: "webhook.office.com", want: false},
+		{name: "empty host", host: "", domain: "webhook.office.com", want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, hasHostSuffix(test.host, test.domain))
+		})
+	}
+}
+
+func TestIsValidWebhookURL(t *testing.T) {
+	var tests = []struct {
+		name    string
+		url     string
+		wantOK  bool
+		wantErr bool
+	}{
+		{name: "office.com prefix", url: "https://outlook.office.com/webhook/xxx", wantOK: true},
+		{name: "office365.com prefix", url: "https://outlook.office365.com/webhook/xxx", wantOK: true},
+		{name: "tenant-scoped webhook.office.com", url: "https://xyz.webhook.office.com/webhookb2/xxx", wantOK: true},
+		{name: "bare webhook.office.com", url: "https://webhook.office.com/webhookb2/xxx", wantOK: true},
+		{name: "tenant-scoped logic.azure.com", url: "https://xyz.logic.azure.com/workflows/xxx", wantOK: true},
+		{name: "tenant-scoped logic.azure.us", url: "https://xyz.logic.azure.us/workflows/xxx", wantOK: true},
+		{name: "unrelated host resembling webhook.office.com", url: "https://notwebhook.office.com/xxx", wantOK: false, wantErr: true},
+		{name: "unknown host", url: "https://example.com/xxx", wantOK: false, wantErr: true},
+		{name: "empty URL", url: "", wantOK: false, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ok, err := IsValidWebhookURL(test.url)
+			assert.Equal(t, test.wantOK, ok)
+			if test.wantErr {
+				assert.True(t, errors.Is(err, ErrWebhookURLUnexpected), "expected err to wrap ErrWebhookURLUnexpected, got %v", err)
+			} else {
+				assert.Equal(t, nil, err)
+			}
+		})
+	}
+}
+
+func TestClientIsValidWebhookURLAcceptsAllowedPrefixes(t *testing.T) {
+	c := &Client{options: &Options{}}
+
+	ok, err := c.IsValidWebhookURL("https://proxy.example.com/webhook/xxx")
+	assert.True(t, !ok)
+	assert.True(t, errors.Is(err, ErrWebhookURLUnexpected))
+
+	c.AddWebhookURLPrefix("https://proxy.example.com")
+
+	ok, err = c.IsValidWebhookURL("https://proxy.example.com/webhook/xxx")
+	assert.True(t, ok)
+	assert.Equal(t, nil, err)
+
+	// A known Microsoft host still works without needing an allow-listed prefix.
+	ok, err = c.IsValidWebhookURL("https://outlook.office.com/webhook/xxx")
+	assert.True(t, ok)
+	assert.Equal(t, nil, err)
+
+	// Prefixes registered via Options at construction time are honored too.
+	c2 := &Client{options: &Options{AllowedWebhookURLPrefixes: []string{"https://other.example.com"}}}
+	ok, err = c2.IsValidWebhookURL("https://other.example.com/webhook/xxx")
+	assert.True(t, ok)
+	assert.Equal(t, nil, err)
+}
+
+// TestAddWebhookURLPrefixConcurrentWithSend guards against the data race
+// between AddWebhookURLPrefix mutating Options.AllowedWebhookURLPrefixes and
+// Send/IsValidWebhookURL reading it; Notifier drives Send from a worker pool,
+// so a caller registering a new prefix while it's running must be safe.
+func TestAddWebhookURLPrefixConcurrentWithSend(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Body: http.NoBody}, nil
+	})
+
+	opts := Options{Timeout: 60 * time.Second}
+	c := &Client{httpClient: httpClient, options: &opts}
+
+	msg := NewMessageCard()
+	msg.Text = "hello"
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			c.AddWebhookURLPrefix("https://tenant.example.com")
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_ = c.Send("https://outlook.office.com/webhook/xxx", msg)
+		}
+	}()
+
+	wg.Wait()
+}